@@ -23,28 +23,90 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/spdx/tools-golang/v0/jsonsaver"
+	"github.com/spdx/tools-golang/v0/rdfsaver"
+	"github.com/spdx/tools-golang/v0/spdx"
+	"github.com/spdx/tools-golang/v0/tvloader"
 	"github.com/spdx/tools-golang/v0/tvsaver"
 	"github.com/swinslow/cncf-exceptions-maker/pkg/exceptionmaker"
 	"golang.org/x/net/context"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
-	"google.golang.org/api/sheets/v4"
 )
 
+// writer saves doc to filename in a particular SPDX serialization.
+type writer func(doc *spdx.Document2_2, filename string) error
+
+// writers maps each individual output backend to the writer that
+// handles it and the file extension it should be saved with.
+var writers = map[string]struct {
+	write writer
+	ext   string
+}{
+	"tv":   {writeTagValue, "spdx"},
+	"json": {writeJSON, "spdx.json"},
+	"rdf":  {writeRDF, "spdx.rdf"},
+}
+
+// formatGroups maps each --format value to the individual backends
+// it should invoke.
+var formatGroups = map[string][]string{
+	"tv":   {"tv"},
+	"json": {"json"},
+	"rdf":  {"rdf"},
+	"both": {"tv", "json"},
+	"all":  {"tv", "json", "rdf"},
+}
+
+// sheetsScope is the OAuth2/ADC scope needed to read the exceptions
+// spreadsheet.
+const sheetsScope = "https://www.googleapis.com/auth/spreadsheets.readonly"
+
+// buildSheetsClient returns an authenticated HTTP client for the
+// Sheets API. It tries Application Default Credentials first - which
+// covers both a service-account key pointed to by
+// GOOGLE_APPLICATION_CREDENTIALS and a workload-identity/metadata-server
+// token when running on GCE/GKE - and only falls back to the
+// interactive installed-app OAuth flow when interactive is true.
+func buildSheetsClient(ctx context.Context, interactive bool, credentialsFile, tokenCache string) (*http.Client, error) {
+	creds, err := google.FindDefaultCredentials(ctx, sheetsScope)
+	if err == nil {
+		return oauth2.NewClient(ctx, creds.TokenSource), nil
+	}
+
+	if !interactive {
+		return nil, fmt.Errorf("no Application Default Credentials found (%v); pass --interactive to use the installed-app OAuth flow instead", err)
+	}
+
+	b, err := ioutil.ReadFile(credentialsFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read client secret file %s: %v", credentialsFile, err)
+	}
+
+	config, err := google.ConfigFromJSON(b, sheetsScope)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse client secret file to config: %v", err)
+	}
+
+	return getClient(config, tokenCache), nil
+}
+
 // Retrieve a token, saves the token, then returns the generated client.
-func getClient(config *oauth2.Config) *http.Client {
-	// The file token.json stores the user's access and refresh tokens, and is
-	// created automatically when the authorization flow completes for the first
-	// time.
-	tokFile := "token.json"
+// This is only used for the interactive installed-app OAuth flow.
+func getClient(config *oauth2.Config, tokFile string) *http.Client {
+	// tokFile stores the user's access and refresh tokens, and is created
+	// automatically when the authorization flow completes for the first time.
 	tok, err := tokenFromFile(tokFile)
 	if err != nil {
 		tok = getTokenFromWeb(config)
@@ -96,7 +158,7 @@ func saveToken(path string, token *oauth2.Token) {
 
 // ExceptionConfig holds the configuration options for the exception maker.
 type ExceptionConfig struct {
-	SpreadsheetID string `json:"spreadsheetId"`
+	Source exceptionmaker.SourceConfig `json:"source"`
 }
 
 func loadConfig(filename string) (*ExceptionConfig, error) {
@@ -115,90 +177,189 @@ func loadConfig(filename string) (*ExceptionConfig, error) {
 }
 
 func main() {
+	format := flag.String("format", "tv", "output format to write: tv, json, rdf, both (tv+json), or all")
+	interactive := flag.Bool("interactive", false, "fall back to the interactive installed-app OAuth flow if Application Default Credentials are not available")
+	credentialsFile := flag.String("credentials-file", "", "path to the OAuth client secret file used by --interactive (default ~/.google-sheets-cncf-exceptions-credentials.json)")
+	tokenCache := flag.String("token-cache", "token.json", "path to cache the OAuth token used by --interactive")
+	diffAgainst := flag.String("diff", "", "path to a previously-generated tag-value .spdx file to compare this run's packages against")
+	flag.Parse()
+
+	*format = strings.ToLower(*format)
+	backends, ok := formatGroups[*format]
+	if !ok {
+		log.Fatalf("invalid --format %q: must be one of tv, json, rdf, both, all", *format)
+	}
+
 	home, err := os.UserHomeDir()
 	if err != nil {
 		log.Fatalf("Unable to get user home directory: %v", err)
 	}
 
-	b, err := ioutil.ReadFile(filepath.Join(home, ".google-sheets-cncf-exceptions-credentials.json"))
-	if err != nil {
-		log.Fatalf("Unable to read client secret file: %v", err)
+	if *credentialsFile == "" {
+		*credentialsFile = filepath.Join(home, ".google-sheets-cncf-exceptions-credentials.json")
 	}
 
-	// If modifying these scopes, delete your previously saved token.json.
-	config, err := google.ConfigFromJSON(b, "https://www.googleapis.com/auth/spreadsheets.readonly")
+	cfg, err := loadConfig(filepath.Join(home, ".cncf-exceptions-config"))
 	if err != nil {
-		log.Fatalf("Unable to parse client secret file to config: %v", err)
+		log.Fatalf("Unable to load config: %v", err)
 	}
-	client := getClient(config)
 
-	srv, err := sheets.New(client)
+	src, err := exceptionmaker.NewExceptionSource(&cfg.Source)
 	if err != nil {
-		log.Fatalf("Unable to retrieve Sheets client: %v", err)
+		log.Fatalf("Unable to build exception source: %v", err)
 	}
 
-	cfg, err := loadConfig(filepath.Join(home, ".cncf-exceptions-config"))
-	readRange := "Approved!A2:I"
-	resp, err := srv.Spreadsheets.Values.Get(cfg.SpreadsheetID, readRange).Do()
+	if sheetsSrc, ok := src.(*exceptionmaker.SheetsSource); ok {
+		client, err := buildSheetsClient(context.Background(), *interactive, *credentialsFile, *tokenCache)
+		if err != nil {
+			log.Fatalf("Unable to authenticate to Sheets: %v", err)
+		}
+
+		if err := sheetsSrc.SetClient(client); err != nil {
+			log.Fatalf("Unable to authenticate Sheets source: %v", err)
+		}
+	}
+
+	rows, err := src.FetchRows(context.Background())
 	if err != nil {
-		log.Fatalf("Unable to retrieve data from sheet: %v", err)
+		log.Fatalf("Unable to fetch exception rows: %v", err)
 	}
 
 	doc := exceptionmaker.MakeDocument()
 
-	if len(resp.Values) == 0 {
+	var invalidLicenseRows []*exceptionmaker.LicenseValidationError
+	var invalidCommentRows []*exceptionmaker.CommentError
+
+	if len(rows) == 0 {
 		fmt.Println("No data found.")
 	} else {
 		rowNum := 2
-		for _, row := range resp.Values {
+		for _, row := range rows {
 			// check whether this row is complete
 			if len(row) < 9 {
 				log.Printf("==> INCOMPLETE ROW (%d): %v\n", len(row), row)
 				continue
 			}
 
-			pkg, err := exceptionmaker.MakePackageFromRow(row, rowNum)
-			if err != nil {
+			pkg, err := exceptionmaker.MakePackageFromRow(row, rowNum, doc)
+			var lve *exceptionmaker.LicenseValidationError
+			var ce *exceptionmaker.CommentError
+			foundLve := errors.As(err, &lve)
+			foundCe := errors.As(err, &ce)
+			if err != nil && !foundLve && !foundCe {
 				log.Fatalf("Unable to convert rowNum %d data to SPDX package: %v\n", rowNum, err)
 			}
+			if lve != nil {
+				invalidLicenseRows = append(invalidLicenseRows, lve)
+			}
+			if ce != nil {
+				invalidCommentRows = append(invalidCommentRows, ce)
+			}
 			doc.Packages = append(doc.Packages, pkg)
 
 			rowNum++
 		}
 	}
 
-	// and write to disk
-	fileOut := fmt.Sprintf("cncf-exceptions-%s.spdx", time.Now().Format("2006-01-02"))
-	w, err := os.Create(fileOut)
+	if len(invalidLicenseRows) > 0 {
+		fmt.Printf("\n==> %d row(s) had invalid or unrecognized SPDX license expressions:\n", len(invalidLicenseRows))
+		for _, lve := range invalidLicenseRows {
+			fmt.Printf("    %v\n", lve)
+		}
+	}
+
+	if len(invalidCommentRows) > 0 {
+		fmt.Printf("\n==> %d row(s) had a package comment that could not be prepared:\n", len(invalidCommentRows))
+		for _, ce := range invalidCommentRows {
+			fmt.Printf("    %v\n", ce)
+		}
+	}
+
+	if *diffAgainst != "" {
+		if err := reportDiff(*diffAgainst, doc); err != nil {
+			log.Fatalf("Unable to diff against %s: %v", *diffAgainst, err)
+		}
+	}
+
+	// and write to disk, in whichever format(s) were requested
+	datestr := time.Now().Format("2006-01-02")
+
+	for _, backend := range backends {
+		w := writers[backend]
+		fileOut := fmt.Sprintf("cncf-exceptions-%s.%s", datestr, w.ext)
+		if err := w.write(doc, fileOut); err != nil {
+			log.Fatalf("Error while saving %v: %v", fileOut, err)
+		}
+		fmt.Printf("Saved exceptions list as SPDX (%s) to %s\n", backend, fileOut)
+	}
+}
+
+// writeTagValue writes doc to filename as an SPDX 2.2 tag-value document.
+func writeTagValue(doc *spdx.Document2_2, filename string) error {
+	w, err := os.Create(filename)
 	if err != nil {
-		log.Fatalf("Error while opening %v for writing: %v", fileOut, err)
+		return fmt.Errorf("error while opening %v for writing: %v", filename, err)
 	}
 	defer w.Close()
 
-	err = tvsaver.Save2_1(doc, w)
+	return tvsaver.Save2_2(doc, w)
+}
+
+// writeJSON writes doc to filename as a spec-compliant SPDX 2.2 JSON document.
+func writeJSON(doc *spdx.Document2_2, filename string) error {
+	w, err := os.Create(filename)
 	if err != nil {
-		log.Fatalf("Error while saving %v: %v", fileOut, err)
+		return fmt.Errorf("error while opening %v for writing: %v", filename, err)
 	}
+	defer w.Close()
+
+	return jsonsaver.Save2_2(doc, w)
+}
 
-	fmt.Printf("Saved exceptions list as SPDX to %s\n", fileOut)
+// reportDiff loads the tag-value SPDX document at prevFile, compares
+// it against newDoc, and prints the resulting DiffReport as text to
+// stdout and as JSON to a cncf-exceptions-diff-<date>.json file.
+func reportDiff(prevFile string, newDoc *spdx.Document2_2) error {
+	f, err := os.Open(prevFile)
+	if err != nil {
+		return fmt.Errorf("unable to open %v: %v", prevFile, err)
+	}
+	defer f.Close()
 
-	subsets := exceptionmaker.ConvertSPDXToJSONPackageSubset(doc)
-	jsonStr, err := json.MarshalIndent(subsets, "", "  ")
+	oldDoc, err := tvloader.Load2_2(f)
 	if err != nil {
-		log.Fatalf("Error while marshalling to JSON: %v", err)
+		return fmt.Errorf("unable to parse %v: %v", prevFile, err)
 	}
 
-	jsonOut := fmt.Sprintf("cncf-exceptions-%s.json", time.Now().Format("2006-01-02"))
+	report := exceptionmaker.Diff(oldDoc, newDoc)
+
+	fmt.Printf("\n==> Diff against %s:\n%s", prevFile, report.Text())
+
+	jsonOut := fmt.Sprintf("cncf-exceptions-diff-%s.json", time.Now().Format("2006-01-02"))
 	j, err := os.Create(jsonOut)
 	if err != nil {
-		log.Fatalf("Error while opening %v for writing: %v", fileOut, err)
+		return fmt.Errorf("error while opening %v for writing: %v", jsonOut, err)
 	}
 	defer j.Close()
 
-	_, err = j.Write(jsonStr)
+	enc := json.NewEncoder(j)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		return fmt.Errorf("error while saving %v: %v", jsonOut, err)
+	}
+
+	fmt.Printf("Saved diff report as JSON to %s\n", jsonOut)
+
+	return nil
+}
+
+// writeRDF writes doc to filename as an SPDX 2.2 RDF/XML document.
+func writeRDF(doc *spdx.Document2_2, filename string) error {
+	w, err := os.Create(filename)
 	if err != nil {
-		log.Fatalf("Error while saving %v: %v", jsonOut, err)
+		return fmt.Errorf("error while opening %v for writing: %v", filename, err)
 	}
+	defer w.Close()
 
-	fmt.Printf("Saved exceptions list as JSON to %s\n", jsonOut)
+	return rdfsaver.Save2_2(doc, w)
 }
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright The Linux Foundation
+
+package exceptionmaker
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spdx/tools-golang/v0/spdx"
+)
+
+// PackageDiff describes how a single package's tracked fields changed
+// between two documents.
+type PackageDiff struct {
+	PackageName string `json:"packageName"`
+	OldLicense  string `json:"oldLicense,omitempty"`
+	NewLicense  string `json:"newLicense,omitempty"`
+	OldComment  string `json:"oldComment,omitempty"`
+	NewComment  string `json:"newComment,omitempty"`
+}
+
+// DiffReport is the result of comparing two exception documents.
+// Packages are matched by PackageName.
+type DiffReport struct {
+	Added   []string      `json:"added"`
+	Removed []string      `json:"removed"`
+	Changed []PackageDiff `json:"changed"`
+}
+
+// Diff compares oldDoc against newDoc and reports which packages were
+// added, removed, or had their concluded license or comment change.
+func Diff(oldDoc, newDoc *spdx.Document2_2) DiffReport {
+	oldByName := map[string]*spdx.Package2_2{}
+	for _, pkg := range oldDoc.Packages {
+		oldByName[pkg.PackageName] = pkg
+	}
+
+	newByName := map[string]*spdx.Package2_2{}
+	for _, pkg := range newDoc.Packages {
+		newByName[pkg.PackageName] = pkg
+	}
+
+	report := DiffReport{}
+
+	for name, newPkg := range newByName {
+		oldPkg, ok := oldByName[name]
+		if !ok {
+			report.Added = append(report.Added, name)
+			continue
+		}
+
+		if oldPkg.PackageLicenseConcluded != newPkg.PackageLicenseConcluded || oldPkg.PackageComment != newPkg.PackageComment {
+			report.Changed = append(report.Changed, PackageDiff{
+				PackageName: name,
+				OldLicense:  oldPkg.PackageLicenseConcluded,
+				NewLicense:  newPkg.PackageLicenseConcluded,
+				OldComment:  oldPkg.PackageComment,
+				NewComment:  newPkg.PackageComment,
+			})
+		}
+	}
+
+	for name := range oldByName {
+		if _, ok := newByName[name]; !ok {
+			report.Removed = append(report.Removed, name)
+		}
+	}
+
+	sort.Strings(report.Added)
+	sort.Strings(report.Removed)
+	sort.Slice(report.Changed, func(i, j int) bool {
+		return report.Changed[i].PackageName < report.Changed[j].PackageName
+	})
+
+	return report
+}
+
+// Text renders the report as a human-readable summary, suitable for
+// printing to a terminal or posting in a Slack message.
+func (r DiffReport) Text() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Added packages (%d):\n", len(r.Added))
+	for _, name := range r.Added {
+		fmt.Fprintf(&b, "  + %s\n", name)
+	}
+
+	fmt.Fprintf(&b, "Removed packages (%d):\n", len(r.Removed))
+	for _, name := range r.Removed {
+		fmt.Fprintf(&b, "  - %s\n", name)
+	}
+
+	fmt.Fprintf(&b, "Changed packages (%d):\n", len(r.Changed))
+	for _, c := range r.Changed {
+		fmt.Fprintf(&b, "  ~ %s\n", c.PackageName)
+		if c.OldLicense != c.NewLicense {
+			fmt.Fprintf(&b, "      license: %q -> %q\n", c.OldLicense, c.NewLicense)
+		}
+		if c.OldComment != c.NewComment {
+			fmt.Fprintf(&b, "      comment: %q -> %q\n", c.OldComment, c.NewComment)
+		}
+	}
+
+	return b.String()
+}
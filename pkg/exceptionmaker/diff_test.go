@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright The Linux Foundation
+
+package exceptionmaker
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spdx/tools-golang/v0/spdx"
+)
+
+func docWithPackages(pkgs ...*spdx.Package2_2) *spdx.Document2_2 {
+	return &spdx.Document2_2{Packages: pkgs}
+}
+
+func TestDiff(t *testing.T) {
+	oldDoc := docWithPackages(
+		&spdx.Package2_2{PackageName: "unchanged", PackageLicenseConcluded: "MIT", PackageComment: "same"},
+		&spdx.Package2_2{PackageName: "changed", PackageLicenseConcluded: "MIT", PackageComment: "old comment"},
+		&spdx.Package2_2{PackageName: "removed", PackageLicenseConcluded: "MIT"},
+	)
+	newDoc := docWithPackages(
+		&spdx.Package2_2{PackageName: "unchanged", PackageLicenseConcluded: "MIT", PackageComment: "same"},
+		&spdx.Package2_2{PackageName: "changed", PackageLicenseConcluded: "Apache-2.0", PackageComment: "new comment"},
+		&spdx.Package2_2{PackageName: "added", PackageLicenseConcluded: "MIT"},
+	)
+
+	report := Diff(oldDoc, newDoc)
+
+	if len(report.Added) != 1 || report.Added[0] != "added" {
+		t.Errorf("Added = %v, want [added]", report.Added)
+	}
+	if len(report.Removed) != 1 || report.Removed[0] != "removed" {
+		t.Errorf("Removed = %v, want [removed]", report.Removed)
+	}
+	if len(report.Changed) != 1 {
+		t.Fatalf("Changed = %v, want 1 entry", report.Changed)
+	}
+
+	c := report.Changed[0]
+	if c.PackageName != "changed" {
+		t.Errorf("Changed[0].PackageName = %q, want %q", c.PackageName, "changed")
+	}
+	if c.OldLicense != "MIT" || c.NewLicense != "Apache-2.0" {
+		t.Errorf("Changed[0] license = %q -> %q, want MIT -> Apache-2.0", c.OldLicense, c.NewLicense)
+	}
+	if c.OldComment != "old comment" || c.NewComment != "new comment" {
+		t.Errorf("Changed[0] comment = %q -> %q, want %q -> %q", c.OldComment, c.NewComment, "old comment", "new comment")
+	}
+}
+
+func TestDiffReportText(t *testing.T) {
+	report := DiffReport{
+		Added:   []string{"added"},
+		Removed: []string{"removed"},
+		Changed: []PackageDiff{
+			{PackageName: "changed", OldLicense: "MIT", NewLicense: "Apache-2.0"},
+		},
+	}
+
+	text := report.Text()
+	for _, want := range []string{"added", "removed", "changed", "MIT", "Apache-2.0"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Text() = %q, want it to contain %q", text, want)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright The Linux Foundation
+
+package exceptionmaker
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// LicenseValidationError reports that a row's SPDXlicenses column did
+// not parse as a valid SPDX license expression, or referenced an
+// identifier that is not in the known SPDX license list.
+type LicenseValidationError struct {
+	RowNum int
+	Expr   string
+	Err    error
+}
+
+func (e *LicenseValidationError) Error() string {
+	return fmt.Sprintf("row %d: invalid SPDX license expression %q: %v", e.RowNum, e.Expr, e.Err)
+}
+
+// Unwrap allows errors.As/errors.Is to see through to the underlying
+// parse or lookup failure.
+func (e *LicenseValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateLicenseExpression checks that expr is a syntactically valid
+// SPDX license expression - made up of identifiers, AND, OR, WITH,
+// "+", and parentheses - and that every license identifier and
+// exception identifier it references is known.
+func ValidateLicenseExpression(expr string) error {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return fmt.Errorf("empty license expression")
+	}
+	if expr == "NOASSERTION" || expr == "NONE" {
+		return nil
+	}
+
+	tokens := tokenizeLicenseExpression(expr)
+	if len(tokens) == 0 {
+		return fmt.Errorf("empty license expression")
+	}
+
+	// needOperand tracks whether the next token must be (the start of)
+	// an operand - a license identifier or an opening parenthesis -
+	// rather than an operator or the end of the expression. It starts
+	// true because the expression must begin with an operand.
+	depth := 0
+	needOperand := true
+	expectException := false
+	for _, tok := range tokens {
+		if expectException {
+			switch tok {
+			case "(", ")", "AND", "OR", "WITH":
+				return fmt.Errorf("WITH with no following exception identifier")
+			}
+		}
+		switch tok {
+		case "(":
+			if !needOperand {
+				return fmt.Errorf("unexpected \"(\": expected an operator")
+			}
+			depth++
+		case ")":
+			if needOperand {
+				return fmt.Errorf("empty or incomplete parenthesized expression")
+			}
+			depth--
+			if depth < 0 {
+				return fmt.Errorf("unbalanced parentheses")
+			}
+		case "AND", "OR":
+			if needOperand {
+				return fmt.Errorf("%s with no preceding license identifier", tok)
+			}
+			needOperand = true
+		case "WITH":
+			if needOperand {
+				return fmt.Errorf("WITH with no preceding license identifier")
+			}
+			expectException = true
+		default:
+			id := strings.TrimSuffix(tok, "+")
+			if expectException {
+				if !knownExceptions[id] {
+					return fmt.Errorf("unknown license exception %q", id)
+				}
+				expectException = false
+			} else {
+				if !needOperand {
+					return fmt.Errorf("unexpected license identifier %q: expected an operator", id)
+				}
+				if !isLicenseRef(id) && !knownLicenses[id] {
+					return fmt.Errorf("unknown license identifier %q", id)
+				}
+				needOperand = false
+			}
+		}
+	}
+
+	if depth != 0 {
+		return fmt.Errorf("unbalanced parentheses")
+	}
+	if expectException {
+		return fmt.Errorf("WITH with no following exception identifier")
+	}
+	if needOperand {
+		return fmt.Errorf("expression ends with a dangling operator")
+	}
+
+	return nil
+}
+
+// isLicenseRef reports whether id is a user-defined license
+// reference rather than a published SPDX license identifier. Per the
+// SPDX spec, "LicenseRef-<idstring>" (optionally qualified with
+// "DocumentRef-<docref>:") is reserved for licenses that aren't on
+// the official list, so these are never checked against
+// knownLicenses.
+func isLicenseRef(id string) bool {
+	if strings.HasPrefix(id, "LicenseRef-") {
+		return true
+	}
+
+	docRef, rest, found := strings.Cut(id, ":")
+	return found && strings.HasPrefix(docRef, "DocumentRef-") && strings.HasPrefix(rest, "LicenseRef-")
+}
+
+// tokenizeLicenseExpression splits an SPDX license expression into
+// identifiers, operators, and parentheses.
+func tokenizeLicenseExpression(expr string) []string {
+	var tokens []string
+	var cur strings.Builder
+
+	flush := func() {
+		if cur.Len() > 0 {
+			tokens = append(tokens, cur.String())
+			cur.Reset()
+		}
+	}
+
+	for _, r := range expr {
+		switch {
+		case r == '(' || r == ')':
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsSpace(r):
+			flush()
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	flush()
+
+	return tokens
+}
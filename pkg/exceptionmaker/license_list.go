@@ -0,0 +1,385 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright The Linux Foundation
+
+package exceptionmaker
+
+// knownLicenses mirrors the identifiers published in the SPDX license
+// list (https://spdx.org/licenses/), including deprecated identifiers
+// that are still valid in expressions for backwards compatibility,
+// plus the "NOASSERTION" and "NONE" special values SPDX reserves for
+// license fields.
+var knownLicenses = map[string]bool{
+	"0BSD":                                 true,
+	"AAL":                                  true,
+	"Abstyles":                             true,
+	"Adobe-2006":                           true,
+	"Adobe-Glyph":                          true,
+	"ADSL":                                 true,
+	"AFL-1.1":                              true,
+	"AFL-1.2":                              true,
+	"AFL-2.0":                              true,
+	"AFL-2.1":                              true,
+	"AFL-3.0":                              true,
+	"Afmparse":                             true,
+	"AGPL-1.0-only":                        true,
+	"AGPL-1.0-or-later":                    true,
+	"AGPL-1.0":                             true,
+	"AGPL-3.0-only":                        true,
+	"AGPL-3.0-or-later":                    true,
+	"AGPL-3.0":                             true,
+	"Aladdin":                              true,
+	"AMDPLPA":                              true,
+	"AML":                                  true,
+	"AMPAS":                                true,
+	"ANTLR-PD":                             true,
+	"Apache-1.0":                           true,
+	"Apache-1.1":                           true,
+	"Apache-2.0":                           true,
+	"APAFML":                               true,
+	"APL-1.0":                              true,
+	"APSL-1.0":                             true,
+	"APSL-1.1":                             true,
+	"APSL-1.2":                             true,
+	"APSL-2.0":                             true,
+	"Artistic-1.0-cl8":                     true,
+	"Artistic-1.0-Perl":                    true,
+	"Artistic-1.0":                         true,
+	"Artistic-2.0":                         true,
+	"Bahyph":                               true,
+	"Barr":                                 true,
+	"Beerware":                             true,
+	"BitTorrent-1.0":                       true,
+	"BitTorrent-1.1":                       true,
+	"Borceux":                              true,
+	"BSD-1-Clause":                         true,
+	"BSD-2-Clause-Patent":                  true,
+	"BSD-2-Clause":                         true,
+	"BSD-3-Clause-Attribution":             true,
+	"BSD-3-Clause-Clear":                   true,
+	"BSD-3-Clause-LBNL":                    true,
+	"BSD-3-Clause-No-Nuclear-License-2014": true,
+	"BSD-3-Clause-No-Nuclear-License":      true,
+	"BSD-3-Clause-No-Nuclear-Warranty":     true,
+	"BSD-3-Clause":                         true,
+	"BSD-4-Clause-UC":                      true,
+	"BSD-4-Clause":                         true,
+	"BSD-Protection":                       true,
+	"BSD-Source-Code":                      true,
+	"BSL-1.0":                              true,
+	"bzip2-1.0.6":                          true,
+	"Caldera":                              true,
+	"CATOSL-1.1":                           true,
+	"CC-BY-1.0":                            true,
+	"CC-BY-2.0":                            true,
+	"CC-BY-2.5":                            true,
+	"CC-BY-3.0":                            true,
+	"CC-BY-4.0":                            true,
+	"CC-BY-NC-4.0":                         true,
+	"CC-BY-NC-ND-4.0":                      true,
+	"CC-BY-NC-SA-4.0":                      true,
+	"CC-BY-ND-4.0":                         true,
+	"CC-BY-SA-1.0":                         true,
+	"CC-BY-SA-2.0":                         true,
+	"CC-BY-SA-2.5":                         true,
+	"CC-BY-SA-3.0":                         true,
+	"CC-BY-SA-4.0":                         true,
+	"CC0-1.0":                              true,
+	"CDDL-1.0":                             true,
+	"CDDL-1.1":                             true,
+	"CDLA-Permissive-1.0":                  true,
+	"CDLA-Sharing-1.0":                     true,
+	"CECILL-1.0":                           true,
+	"CECILL-1.1":                           true,
+	"CECILL-2.0":                           true,
+	"CECILL-2.1":                           true,
+	"CECILL-B":                             true,
+	"CECILL-C":                             true,
+	"ClArtistic":                           true,
+	"CNRI-Jython":                          true,
+	"CNRI-Python-GPL-Compatible":           true,
+	"CNRI-Python":                          true,
+	"Condor-1.1":                           true,
+	"CPAL-1.0":                             true,
+	"CPL-1.0":                              true,
+	"CPOL-1.02":                            true,
+	"Crossword":                            true,
+	"CrystalStacker":                       true,
+	"CUA-OPL-1.0":                          true,
+	"Cube":                                 true,
+	"curl":                                 true,
+	"D-FSL-1.0":                            true,
+	"diffmark":                             true,
+	"DOC":                                  true,
+	"Dotseqn":                              true,
+	"DSDP":                                 true,
+	"dvipdfm":                              true,
+	"ECL-1.0":                              true,
+	"ECL-2.0":                              true,
+	"EFL-1.0":                              true,
+	"EFL-2.0":                              true,
+	"eGenix":                               true,
+	"Entessa":                              true,
+	"EPL-1.0":                              true,
+	"EPL-2.0":                              true,
+	"ErlPL-1.1":                            true,
+	"EUDatagrid":                           true,
+	"EUPL-1.0":                             true,
+	"EUPL-1.1":                             true,
+	"EUPL-1.2":                             true,
+	"Eurosym":                              true,
+	"Fair":                                 true,
+	"Frameworx-1.0":                        true,
+	"FreeImage":                            true,
+	"FSFAP":                                true,
+	"FSFUL":                                true,
+	"FSFULLR":                              true,
+	"FTL":                                  true,
+	"GFDL-1.1-only":                        true,
+	"GFDL-1.1-or-later":                    true,
+	"GFDL-1.1":                             true,
+	"GFDL-1.2-only":                        true,
+	"GFDL-1.2-or-later":                    true,
+	"GFDL-1.2":                             true,
+	"GFDL-1.3-only":                        true,
+	"GFDL-1.3-or-later":                    true,
+	"GFDL-1.3":                             true,
+	"Giftware":                             true,
+	"GL2PS":                                true,
+	"Glide":                                true,
+	"Glulxe":                               true,
+	"gnuplot":                              true,
+	"GPL-1.0-only":                         true,
+	"GPL-1.0-or-later":                     true,
+	"GPL-1.0":                              true,
+	"GPL-2.0-only":                         true,
+	"GPL-2.0-or-later":                     true,
+	"GPL-2.0":                              true,
+	"GPL-3.0-only":                         true,
+	"GPL-3.0-or-later":                     true,
+	"GPL-3.0":                              true,
+	"gSOAP-1.3b":                           true,
+	"HaskellReport":                        true,
+	"HPND":                                 true,
+	"IBM-pibs":                             true,
+	"ICU":                                  true,
+	"IJG":                                  true,
+	"ImageMagick":                          true,
+	"iMatix":                               true,
+	"Imlib2":                               true,
+	"Info-ZIP":                             true,
+	"Intel-ACPI":                           true,
+	"Intel":                                true,
+	"Interbase-1.0":                        true,
+	"IPA":                                  true,
+	"IPL-1.0":                              true,
+	"ISC":                                  true,
+	"JasPer-2.0":                           true,
+	"JSON":                                 true,
+	"LAL-1.2":                              true,
+	"LAL-1.3":                              true,
+	"Latex2e":                              true,
+	"Leptonica":                            true,
+	"LGPL-2.0-only":                        true,
+	"LGPL-2.0-or-later":                    true,
+	"LGPL-2.0":                             true,
+	"LGPL-2.1-only":                        true,
+	"LGPL-2.1-or-later":                    true,
+	"LGPL-2.1":                             true,
+	"LGPL-3.0-only":                        true,
+	"LGPL-3.0-or-later":                    true,
+	"LGPL-3.0":                             true,
+	"LGPLLR":                               true,
+	"Libpng":                               true,
+	"libtiff":                              true,
+	"LiLiQ-P-1.1":                          true,
+	"LiLiQ-R-1.1":                          true,
+	"LiLiQ-Rplus-1.1":                      true,
+	"Linux-OpenIB":                         true,
+	"LPL-1.0":                              true,
+	"LPL-1.02":                             true,
+	"LPPL-1.0":                             true,
+	"LPPL-1.1":                             true,
+	"LPPL-1.2":                             true,
+	"LPPL-1.3a":                            true,
+	"LPPL-1.3c":                            true,
+	"MakeIndex":                            true,
+	"MirOS":                                true,
+	"MIT-0":                                true,
+	"MIT-advertising":                      true,
+	"MIT-CMU":                              true,
+	"MIT-enna":                             true,
+	"MIT-feh":                              true,
+	"MIT":                                  true,
+	"MITNFA":                               true,
+	"Motosoto":                             true,
+	"mpich2":                               true,
+	"MPL-1.0":                              true,
+	"MPL-1.1":                              true,
+	"MPL-2.0":                              true,
+	"MS-PL":                                true,
+	"MS-RL":                                true,
+	"MTLL":                                 true,
+	"Multics":                              true,
+	"Mup":                                  true,
+	"NASA-1.3":                             true,
+	"Naumen":                               true,
+	"NBPL-1.0":                             true,
+	"NCSA":                                 true,
+	"Net-SNMP":                             true,
+	"NetCDF":                               true,
+	"Newsletr":                             true,
+	"NGPL":                                 true,
+	"NLOD-1.0":                             true,
+	"NLPL":                                 true,
+	"Nokia":                                true,
+	"NOASSERTION":                          true,
+	"NONE":                                 true,
+	"NOSL":                                 true,
+	"Noweb":                                true,
+	"NPL-1.0":                              true,
+	"NPL-1.1":                              true,
+	"NPOSL-3.0":                            true,
+	"NRL":                                  true,
+	"NTP":                                  true,
+	"OCCT-PL":                              true,
+	"OCLC-2.0":                             true,
+	"ODbL-1.0":                             true,
+	"OFL-1.0":                              true,
+	"OFL-1.1":                              true,
+	"OGTSL":                                true,
+	"OLDAP-1.1":                            true,
+	"OLDAP-1.2":                            true,
+	"OLDAP-1.3":                            true,
+	"OLDAP-1.4":                            true,
+	"OLDAP-2.0.1":                          true,
+	"OLDAP-2.0":                            true,
+	"OLDAP-2.1":                            true,
+	"OLDAP-2.2.1":                          true,
+	"OLDAP-2.2.2":                          true,
+	"OLDAP-2.2":                            true,
+	"OLDAP-2.3":                            true,
+	"OLDAP-2.4":                            true,
+	"OLDAP-2.5":                            true,
+	"OLDAP-2.6":                            true,
+	"OLDAP-2.7":                            true,
+	"OLDAP-2.8":                            true,
+	"OML":                                  true,
+	"OpenSSL":                              true,
+	"OPL-1.0":                              true,
+	"OSET-PL-2.1":                          true,
+	"OSL-1.0":                              true,
+	"OSL-1.1":                              true,
+	"OSL-2.0":                              true,
+	"OSL-2.1":                              true,
+	"OSL-3.0":                              true,
+	"PDDL-1.0":                             true,
+	"PHP-3.0":                              true,
+	"PHP-3.01":                             true,
+	"Plexus":                               true,
+	"PostgreSQL":                           true,
+	"psfrag":                               true,
+	"psutils":                              true,
+	"Python-2.0":                           true,
+	"Qhull":                                true,
+	"QPL-1.0":                              true,
+	"Rdisc":                                true,
+	"RHeCos-1.1":                           true,
+	"RPL-1.1":                              true,
+	"RPL-1.5":                              true,
+	"RPSL-1.0":                             true,
+	"RSA-MD":                               true,
+	"RSCPL":                                true,
+	"Ruby":                                 true,
+	"SAX-PD":                               true,
+	"Saxpath":                              true,
+	"SCEA":                                 true,
+	"Sendmail":                             true,
+	"SGI-B-1.0":                            true,
+	"SGI-B-1.1":                            true,
+	"SGI-B-2.0":                            true,
+	"SimPL-2.0":                            true,
+	"SISSL-1.2":                            true,
+	"SISSL":                                true,
+	"Sleepycat":                            true,
+	"SMLNJ":                                true,
+	"SMPPL":                                true,
+	"SNIA":                                 true,
+	"Spencer-86":                           true,
+	"Spencer-94":                           true,
+	"Spencer-99":                           true,
+	"SPL-1.0":                              true,
+	"SugarCRM-1.1.3":                       true,
+	"SWL":                                  true,
+	"TCL":                                  true,
+	"TCP-wrappers":                         true,
+	"TMate":                                true,
+	"TORQUE-1.1":                           true,
+	"TOSL":                                 true,
+	"Unicode-DFS-2015":                     true,
+	"Unicode-DFS-2016":                     true,
+	"Unicode-TOU":                          true,
+	"Unlicense":                            true,
+	"UPL-1.0":                              true,
+	"Vim":                                  true,
+	"VOSTROM":                              true,
+	"VSL-1.0":                              true,
+	"W3C-19980720":                         true,
+	"W3C-20150513":                         true,
+	"W3C":                                  true,
+	"Watcom-1.0":                           true,
+	"Wsuwp":                                true,
+	"WTFPL":                                true,
+	"X11":                                  true,
+	"Xerox":                                true,
+	"XFree86-1.1":                          true,
+	"xinetd":                               true,
+	"Xnet":                                 true,
+	"xpp":                                  true,
+	"XSkat":                                true,
+	"YPL-1.0":                              true,
+	"YPL-1.1":                              true,
+	"Zed":                                  true,
+	"Zend-2.0":                             true,
+	"Zimbra-1.3":                           true,
+	"Zimbra-1.4":                           true,
+	"zlib-acknowledgement":                 true,
+	"Zlib":                                 true,
+	"ZPL-1.1":                              true,
+	"ZPL-2.0":                              true,
+	"ZPL-2.1":                              true,
+}
+
+// knownExceptions mirrors the identifiers published in the SPDX
+// license exceptions list, used on the right-hand side of a WITH
+// clause in a license expression.
+var knownExceptions = map[string]bool{
+	"389-exception":                     true,
+	"Autoconf-exception-2.0":            true,
+	"Autoconf-exception-3.0":            true,
+	"Bison-exception-2.2":               true,
+	"Bootloader-exception":              true,
+	"Classpath-exception-2.0":           true,
+	"CLISP-exception-2.0":               true,
+	"eCos-exception-2.0":                true,
+	"FLTK-exception":                    true,
+	"Fontconfig-exception-2.0":          true,
+	"freertos-exception-2.0":            true,
+	"GCC-exception-2.0":                 true,
+	"GCC-exception-3.1":                 true,
+	"gnu-javamail-exception":            true,
+	"GPL-3.0-linking-exception":         true,
+	"i2p-gpl-java-exception":            true,
+	"Libtool-exception":                 true,
+	"Linux-syscall-note":                true,
+	"LLVM-exception":                    true,
+	"LZMA-exception":                    true,
+	"mif-exception":                     true,
+	"Nokia-Qt-exception-1.1":            true,
+	"OCCT-exception-1.0":                true,
+	"OpenJDK-assembly-exception-1.0":    true,
+	"openvpn-openssl-exception":         true,
+	"PS-or-PDF-font-exception-20170817": true,
+	"Qwt-exception-1.0":                 true,
+	"u-boot-exception-2.0":              true,
+	"WxWindows-exception-3.1":           true,
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright The Linux Foundation
+
+package exceptionmaker
+
+import "testing"
+
+func TestValidateLicenseExpression(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr bool
+	}{
+		{name: "single identifier", expr: "MIT", wantErr: false},
+		{name: "or-later suffix", expr: "GPL-2.0+", wantErr: false},
+		{name: "and", expr: "MIT AND Apache-2.0", wantErr: false},
+		{name: "or", expr: "MIT OR Apache-2.0", wantErr: false},
+		{name: "with exception", expr: "GPL-2.0-only WITH Classpath-exception-2.0", wantErr: false},
+		{name: "parenthesized", expr: "(MIT OR Apache-2.0) AND GPL-2.0-only", wantErr: false},
+		{name: "license ref", expr: "LicenseRef-Foo", wantErr: false},
+		{name: "document ref license ref", expr: "DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-1", wantErr: false},
+		{name: "noassertion", expr: "NOASSERTION", wantErr: false},
+		{name: "none", expr: "NONE", wantErr: false},
+
+		{name: "empty expression", expr: "", wantErr: true},
+		{name: "dangling and", expr: "MIT AND", wantErr: true},
+		{name: "dangling or", expr: "MIT OR", wantErr: true},
+		{name: "empty parens", expr: "()", wantErr: true},
+		{name: "empty parens after operator", expr: "MIT AND ()", wantErr: true},
+		{name: "dangling and inside parens", expr: "(MIT AND) GPL-2.0-only", wantErr: true},
+		{name: "unbalanced parens", expr: "(MIT", wantErr: true},
+		{name: "unknown license", expr: "Not-A-Real-License", wantErr: true},
+		{name: "unknown exception", expr: "MIT WITH Not-A-Real-Exception", wantErr: true},
+		{name: "with no preceding identifier", expr: "WITH Classpath-exception-2.0", wantErr: true},
+		{name: "dangling with", expr: "MIT WITH", wantErr: true},
+		{name: "missing operator between identifiers", expr: "MIT Apache-2.0", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLicenseExpression(tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateLicenseExpression(%q) = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright The Linux Foundation
+
+package exceptionmaker
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// csvHeaderFirstCell is the expected first-column header text for a
+// CSV export of the exceptions spreadsheet, used to detect and skip
+// a header row the same way SheetsSource's "Approved!A2:I" range
+// skips it.
+const csvHeaderFirstCell = "component name"
+
+// rowsFromCSV reads r as CSV and returns one []interface{} per row,
+// with each cell as a string. A leading header row - recognized by
+// its first column reading "Component Name" - is skipped.
+func rowsFromCSV(r io.Reader) ([][]interface{}, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse CSV: %v", err)
+	}
+
+	if len(records) > 0 && len(records[0]) > 0 &&
+		strings.EqualFold(strings.TrimSpace(records[0][0]), csvHeaderFirstCell) {
+		records = records[1:]
+	}
+
+	rows := make([][]interface{}, len(records))
+	for i, record := range records {
+		row := make([]interface{}, len(record))
+		for j, cell := range record {
+			row[j] = cell
+		}
+		rows[i] = row
+	}
+
+	return rows, nil
+}
+
+// rowsFromJSON reads r as a JSON array of string arrays (matching the
+// shape of the "values" field of a Google Sheets Values.Get response,
+// not the whole response object) and returns one
+// []interface{} per row.
+func rowsFromJSON(r io.Reader) ([][]interface{}, error) {
+	var records [][]string
+	if err := json.NewDecoder(r).Decode(&records); err != nil {
+		return nil, fmt.Errorf("unable to parse JSON: %v", err)
+	}
+
+	rows := make([][]interface{}, len(records))
+	for i, record := range records {
+		row := make([]interface{}, len(record))
+		for j, cell := range record {
+			row[j] = cell
+		}
+		rows[i] = row
+	}
+
+	return rows, nil
+}
+
+// rowsFromFormat dispatches to rowsFromCSV or rowsFromJSON based on
+// format ("csv" or "json").
+func rowsFromFormat(r io.Reader, format string) ([][]interface{}, error) {
+	switch format {
+	case "", "csv":
+		return rowsFromCSV(r)
+	case "json":
+		return rowsFromJSON(r)
+	default:
+		return nil, fmt.Errorf("unrecognized format %q: must be csv or json", format)
+	}
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright The Linux Foundation
+
+package exceptionmaker
+
+import (
+	"context"
+	"fmt"
+)
+
+// ExceptionSource retrieves the raw exception rows that will be
+// converted into SPDX packages. Each row is expected to have the
+// same shape as a row returned by the Google Sheets API: a slice
+// of cell values, in column order.
+type ExceptionSource interface {
+	FetchRows(ctx context.Context) ([][]interface{}, error)
+}
+
+// SourceConfig holds the configuration needed to build an
+// ExceptionSource. Only the fields relevant to the selected Type
+// need be set.
+type SourceConfig struct {
+	// Type selects which ExceptionSource implementation to build:
+	// "sheets", "csv", "http", or "github".
+	Type string `json:"type"`
+
+	// Sheets fields
+	SpreadsheetID string `json:"spreadsheetId"`
+	ReadRange     string `json:"readRange"`
+
+	// CSV fields (Path is also used by the GitHub source)
+	Path string `json:"path"`
+
+	// HTTP fields (Format is also used by the GitHub source)
+	URL    string `json:"url"`
+	Format string `json:"format"` // "csv" or "json"
+
+	// GitHub fields, in addition to Path and Format
+	Owner string `json:"owner"`
+	Repo  string `json:"repo"`
+	Ref   string `json:"ref"`
+	Token string `json:"token"`
+}
+
+// NewExceptionSource builds the ExceptionSource named by cfg.Type.
+func NewExceptionSource(cfg *SourceConfig) (ExceptionSource, error) {
+	switch cfg.Type {
+	case "sheets":
+		return NewSheetsSource(cfg.SpreadsheetID, cfg.ReadRange)
+	case "csv":
+		return NewCSVSource(cfg.Path), nil
+	case "http":
+		return NewHTTPSource(cfg.URL, cfg.Format)
+	case "github":
+		return NewGitHubSource(cfg.Owner, cfg.Repo, cfg.Path, cfg.Ref, cfg.Format, cfg.Token)
+	default:
+		return nil, fmt.Errorf("unrecognized source type %q: must be one of sheets, csv, http, github", cfg.Type)
+	}
+}
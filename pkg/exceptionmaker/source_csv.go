@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright The Linux Foundation
+
+package exceptionmaker
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+// CSVSource fetches exception rows from a local CSV file.
+type CSVSource struct {
+	path string
+}
+
+// NewCSVSource creates a CSVSource that reads from the given path.
+func NewCSVSource(path string) *CSVSource {
+	return &CSVSource{path: path}
+}
+
+// FetchRows reads and parses the CSV file. ctx is unused, since
+// reading a local file cannot be cancelled partway through.
+func (s *CSVSource) FetchRows(ctx context.Context) ([][]interface{}, error) {
+	f, err := os.Open(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open %s: %v", s.path, err)
+	}
+	defer f.Close()
+
+	return rowsFromCSV(f)
+}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright The Linux Foundation
+
+package exceptionmaker
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// GitHubSource fetches exception rows from a CSV or JSON file hosted
+// in a GitHub repository, via the GitHub contents API.
+type GitHubSource struct {
+	owner  string
+	repo   string
+	path   string
+	ref    string
+	format string
+	token  string
+	Client *http.Client
+}
+
+// NewGitHubSource creates a GitHubSource for the file at path within
+// owner/repo. ref is a branch, tag, or commit SHA, and may be left
+// empty to use the repository's default branch. token is an optional
+// GitHub personal access token, used to read private repositories or
+// to avoid unauthenticated rate limits.
+func NewGitHubSource(owner, repo, path, ref, format, token string) (*GitHubSource, error) {
+	if owner == "" || repo == "" || path == "" {
+		return nil, fmt.Errorf("GitHubSource requires owner, repo, and path")
+	}
+
+	return &GitHubSource{
+		owner:  owner,
+		repo:   repo,
+		path:   path,
+		ref:    ref,
+		format: format,
+		token:  token,
+		Client: http.DefaultClient,
+	}, nil
+}
+
+// FetchRows downloads the raw file contents from GitHub and parses
+// them according to the configured format.
+func (s *GitHubSource) FetchRows(ctx context.Context) ([][]interface{}, error) {
+	url := fmt.Sprintf("https://api.github.com/repos/%s/%s/contents/%s", s.owner, s.repo, s.path)
+	if s.ref != "" {
+		url = fmt.Sprintf("%s?ref=%s", url, s.ref)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build request for %s: %v", url, err)
+	}
+	req.Header.Set("Accept", "application/vnd.github.v3.raw")
+	if s.token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("token %s", s.token))
+	}
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch %s from GitHub: %v", s.path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d fetching %s from GitHub", resp.StatusCode, s.path)
+	}
+
+	return rowsFromFormat(resp.Body, s.format)
+}
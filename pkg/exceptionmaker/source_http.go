@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright The Linux Foundation
+
+package exceptionmaker
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// HTTPSource fetches exception rows from a CSV or JSON document
+// served over HTTP.
+type HTTPSource struct {
+	url    string
+	format string
+	Client *http.Client
+}
+
+// NewHTTPSource creates an HTTPSource for the given URL. format
+// selects how the response body is parsed: "csv" (the default) or
+// "json".
+func NewHTTPSource(url, format string) (*HTTPSource, error) {
+	if url == "" {
+		return nil, fmt.Errorf("HTTPSource requires a URL")
+	}
+
+	return &HTTPSource{
+		url:    url,
+		format: format,
+		Client: http.DefaultClient,
+	}, nil
+}
+
+// FetchRows downloads and parses the document at the configured URL.
+func (s *HTTPSource) FetchRows(ctx context.Context) ([][]interface{}, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build request for %s: %v", s.url, err)
+	}
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch %s: %v", s.url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d fetching %s", resp.StatusCode, s.url)
+	}
+
+	return rowsFromFormat(resp.Body, s.format)
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright The Linux Foundation
+
+package exceptionmaker
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+// defaultReadRange is used when a SheetsSource is not given an
+// explicit range to read.
+const defaultReadRange = "Approved!A2:I"
+
+// SheetsSource fetches exception rows from a Google Sheets
+// spreadsheet.
+type SheetsSource struct {
+	srv           *sheets.Service
+	spreadsheetID string
+	readRange     string
+}
+
+// NewSheetsSource creates a SheetsSource for the given spreadsheet
+// ID and A1-notation range. It has no HTTP client until SetClient is
+// called; FetchRows returns an error before then.
+func NewSheetsSource(spreadsheetID, readRange string) (*SheetsSource, error) {
+	if readRange == "" {
+		readRange = defaultReadRange
+	}
+
+	return &SheetsSource{
+		spreadsheetID: spreadsheetID,
+		readRange:     readRange,
+	}, nil
+}
+
+// SetClient assigns the authenticated HTTP client the SheetsSource
+// should use to talk to the Sheets API.
+func (s *SheetsSource) SetClient(client *http.Client) error {
+	srv, err := sheets.New(client)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve Sheets client: %v", err)
+	}
+	s.srv = srv
+	return nil
+}
+
+// FetchRows retrieves the configured range from the spreadsheet.
+func (s *SheetsSource) FetchRows(ctx context.Context) ([][]interface{}, error) {
+	if s.srv == nil {
+		return nil, fmt.Errorf("SheetsSource has no authenticated client; call SetClient first")
+	}
+
+	resp, err := s.srv.Spreadsheets.Values.Get(s.spreadsheetID, s.readRange).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve data from sheet: %v", err)
+	}
+
+	return resp.Values, nil
+}
@@ -4,6 +4,7 @@
 package exceptionmaker
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
 	"strings"
@@ -12,12 +13,30 @@ import (
 	"github.com/spdx/tools-golang/v0/spdx"
 )
 
-// MakeDocument creates an SPDX Document2_1 entry to which
+// CommentError reports that a row's PackageComment could not be
+// derived - for example because the row was marked "N/A" for
+// whitelisted without an Apache-2.0 approval mechanism to explain why.
+type CommentError struct {
+	RowNum int
+	Err    error
+}
+
+func (e *CommentError) Error() string {
+	return fmt.Sprintf("row %d: unable to prepare package comment: %v", e.RowNum, e.Err)
+}
+
+// Unwrap allows errors.As/errors.Is to see through to the underlying
+// failure.
+func (e *CommentError) Unwrap() error {
+	return e.Err
+}
+
+// MakeDocument creates an SPDX Document2_2 entry to which
 // Packages will be added.
-func MakeDocument() *spdx.Document2_1 {
+func MakeDocument() *spdx.Document2_2 {
 	datestr := time.Now().Format("2006-01-02")
-	ci := &spdx.CreationInfo2_1{
-		SPDXVersion:          "SPDX-2.1",
+	ci := &spdx.CreationInfo2_2{
+		SPDXVersion:          "SPDX-2.2",
 		DataLicense:          "CC0-1.0",
 		SPDXIdentifier:       "SPDXRef-DOCUMENT",
 		DocumentName:         fmt.Sprintf("cncf-exceptions-%s", datestr),
@@ -27,24 +46,41 @@ func MakeDocument() *spdx.Document2_1 {
 		Created:              time.Now().Format("2006-01-02T15:04:05Z"),
 	}
 
-	return &spdx.Document2_1{
-		CreationInfo: ci,
-		Packages:     []*spdx.Package2_1{},
+	return &spdx.Document2_2{
+		CreationInfo:  ci,
+		Packages:      []*spdx.Package2_2{},
+		Relationships: []*spdx.Relationship2_2{},
 	}
 }
 
-// MakePackageFromRow creates an SPDX Package2_1 entry based on
-// the contents of the spreadsheet row. It modifies and cleans up
-// the data before returning the row.
-func MakePackageFromRow(row []interface{}, rowNum int) (*spdx.Package2_1, error) {
+// MakePackageFromRow creates an SPDX Package2_2 entry based on
+// the contents of the spreadsheet row, and records its relationships
+// in doc. It modifies and cleans up the data before returning the
+// row.
+//
+// Every package gets a "SPDXRef-DOCUMENT DESCRIBES" relationship. If
+// the row's GitHub repo column names a parent project that an
+// earlier row already turned into a package, a DEPENDS_ON
+// relationship is added between the two; otherwise, if the column is
+// set but no matching package exists yet, an OTHER relationship is
+// recorded with the parent project's name in the comment.
+//
+// If rd.SPDXlicenses does not parse as a valid SPDX license
+// expression, or references an unknown identifier, or if the row's
+// PackageComment could not be derived, the package is still returned
+// (with PackageLicenseConcluded set to whatever was in the
+// spreadsheet, and PackageComment left blank as needed) alongside a
+// joined error wrapping a *CommentError and/or *LicenseValidationError
+// so that the caller can flag the row rather than abort the whole run.
+func MakePackageFromRow(row []interface{}, rowNum int, doc *spdx.Document2_2) (*spdx.Package2_2, error) {
 	rd, err := convertRow(row)
 	if err != nil {
 		return nil, fmt.Errorf("unable to extract details from row: %v", err)
 	}
 	parseRowDetails(rd)
-	cmt, err := prepComment(rd)
+	cmt, cmtErr := prepComment(rd)
 
-	pkg := &spdx.Package2_1{
+	pkg := &spdx.Package2_2{
 		PackageName:             rd.componentName,
 		PackageSPDXIdentifier:   fmt.Sprintf("SPDXRef-Package%d", rowNum),
 		PackageDownloadLocation: "NOASSERTION",
@@ -62,7 +98,57 @@ func MakePackageFromRow(row []interface{}, rowNum int) (*spdx.Package2_1, error)
 		pkg.PackageComment = cmt
 	}
 
-	return pkg, nil
+	doc.Relationships = append(doc.Relationships, MakeDescribesRelationship(pkg))
+	if rel := makeParentProjectRelationship(rd, pkg, doc); rel != nil {
+		doc.Relationships = append(doc.Relationships, rel)
+	}
+
+	var rowErr error
+	if cmtErr != nil {
+		rowErr = errors.Join(rowErr, &CommentError{RowNum: rowNum, Err: cmtErr})
+	}
+	if err := ValidateLicenseExpression(rd.SPDXlicenses); err != nil {
+		rowErr = errors.Join(rowErr, &LicenseValidationError{RowNum: rowNum, Expr: rd.SPDXlicenses, Err: err})
+	}
+
+	return pkg, rowErr
+}
+
+// MakeDescribesRelationship creates the standard
+// "SPDXRef-DOCUMENT DESCRIBES" relationship for a package that has
+// just been added to the document.
+func MakeDescribesRelationship(pkg *spdx.Package2_2) *spdx.Relationship2_2 {
+	return &spdx.Relationship2_2{
+		RefA:         "SPDXRef-DOCUMENT",
+		RefB:         pkg.PackageSPDXIdentifier,
+		Relationship: "DESCRIBES",
+	}
+}
+
+// makeParentProjectRelationship records that pkg depends on (or is
+// otherwise related to) the upstream project named in rd.githubRepo,
+// if any.
+func makeParentProjectRelationship(rd *rowDetails, pkg *spdx.Package2_2, doc *spdx.Document2_2) *spdx.Relationship2_2 {
+	if rd.githubRepo == "" || rd.githubRepo == rd.componentName {
+		return nil
+	}
+
+	for _, existing := range doc.Packages {
+		if existing.PackageName == rd.githubRepo {
+			return &spdx.Relationship2_2{
+				RefA:         pkg.PackageSPDXIdentifier,
+				RefB:         existing.PackageSPDXIdentifier,
+				Relationship: "DEPENDS_ON",
+			}
+		}
+	}
+
+	return &spdx.Relationship2_2{
+		RefA:                pkg.PackageSPDXIdentifier,
+		RefB:                "NOASSERTION",
+		Relationship:        "OTHER",
+		RelationshipComment: fmt.Sprintf("parent project: %s", rd.githubRepo),
+	}
 }
 
 type rowDetails struct {